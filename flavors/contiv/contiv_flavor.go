@@ -159,6 +159,9 @@ func (f *FlavorContiv) Inject() bool {
 	f.Linux.Watcher = &datasync.CompositeKVProtoWatcher{Adapters: []datasync.KeyValProtoWatcher{&f.KVProxy, local_sync.Get()}}
 	f.Linux.Deps.PluginInfraDeps = *f.FlavorLocal.InfraDeps("linux", local.WithConf())
 	f.Linux.Deps.WatchEventsMutex = &watchEventsMutex
+	f.Linux.Deps.Prometheus = &f.Prometheus
+	f.Linux.Deps.GRPC = &f.GRPC
+	f.Linux.Deps.HTTPHandlers = &f.HTTP
 
 	f.VPP.Watch = &datasync.CompositeKVProtoWatcher{Adapters: []datasync.KeyValProtoWatcher{&f.KVProxy, local_sync.Get()}}
 	f.VPP.Deps.PluginInfraDeps = *f.FlavorLocal.InfraDeps("default-plugins", local.WithConf())